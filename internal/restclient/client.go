@@ -0,0 +1,94 @@
+// Package restclient is a small JSON REST client for the Frontegg
+// management API, shared by every resource and data source in the
+// provider.
+package restclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Client issues authenticated JSON requests against the Frontegg API.
+type Client struct {
+	HTTPClient *http.Client
+	Token      string
+}
+
+// NewClient returns a Client that authenticates requests with token.
+func NewClient(token string) *Client {
+	return &Client{
+		HTTPClient: http.DefaultClient,
+		Token:      token,
+	}
+}
+
+// Get issues a GET request to path. When query is non-empty, all of its
+// values (empty ones included) are sent as URL query parameters so that
+// filtering happens server-side rather than requiring the caller to fetch
+// every record and filter in Go.
+func (c *Client) Get(ctx context.Context, path string, query url.Values, out interface{}) error {
+	if len(query) > 0 {
+		path = path + "?" + query.Encode()
+	}
+	return c.do(ctx, http.MethodGet, path, nil, out)
+}
+
+// Post issues a POST request to path with in as the JSON request body.
+func (c *Client) Post(ctx context.Context, path string, in, out interface{}) error {
+	return c.do(ctx, http.MethodPost, path, in, out)
+}
+
+// Patch issues a PATCH request to path with in as the JSON request body.
+func (c *Client) Patch(ctx context.Context, path string, in, out interface{}) error {
+	return c.do(ctx, http.MethodPatch, path, in, out)
+}
+
+// Delete issues a DELETE request to path with in as the JSON request body.
+func (c *Client) Delete(ctx context.Context, path string, in interface{}) error {
+	return c.do(ctx, http.MethodDelete, path, in, nil)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("restclient: marshaling request body: %w", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, path, body)
+	if err != nil {
+		return fmt.Errorf("restclient: building request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("restclient: %s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("restclient: %s %s: %s: %s", method, path, resp.Status, data)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("restclient: decoding response from %s %s: %w", method, path, err)
+	}
+	return nil
+}
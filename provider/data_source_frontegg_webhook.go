@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/benesch/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFronteggWebhook() *schema.Resource {
+	return &schema.Resource{
+		Description: "Looks up a single Frontegg webhook by name, URL, or subscribed event.",
+
+		ReadContext: dataSourceFronteggWebhookRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description:  "The name of the webhook to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"name", "url", "event"},
+			},
+			"url": {
+				Description:  "The URL of the webhook to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"name", "url", "event"},
+			},
+			"event": {
+				Description:  "An event key that the webhook must be subscribed to.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"name", "url", "event"},
+			},
+			"id": {
+				Description: "The ID of the webhook.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"enabled": {
+				Description: "Whether the webhook is enabled.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"description": {
+				Description: "A human-readable description of the webhook.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"secret": {
+				Description: "A secret to include with the event.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"events": {
+				Description: "The names of the events the webhook is subscribed to.",
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+			},
+			"type": {
+				Description: "The type of the webhook.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"tenant_id": {
+				Description: "The ID of the tenant that owns the webhook.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"vendor_id": {
+				Description: "The ID of the vendor that owns the webhook.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "The timestamp at which the webhook was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceFronteggWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*restclient.Client)
+
+	name := d.Get("name").(string)
+	webhookURL := d.Get("url").(string)
+	event := d.Get("event").(string)
+
+	query := url.Values{}
+	if name != "" {
+		query.Set("name", name)
+	}
+	if webhookURL != "" {
+		query.Set("url", webhookURL)
+	}
+	if event != "" {
+		query.Set("eventKey", event)
+	}
+
+	var out []fronteggWebhook
+	if err := client.Get(ctx, fronteggWebhookPath, query, &out); err != nil {
+		return diag.FromErr(err)
+	}
+	if len(out) == 0 {
+		return diag.Errorf("no frontegg_webhook matched the given name, url, and event filters")
+	}
+	match := &out[0]
+
+	d.SetId(match.ID)
+	if err := d.Set("enabled", match.IsActive); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", match.DisplayName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", match.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("url", match.URL); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("secret", match.Secret); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("events", match.EventKeys); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", match.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tenant_id", match.TenantID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("vendor_id", match.VendorID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created_at", match.CreatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
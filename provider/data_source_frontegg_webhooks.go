@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	"github.com/benesch/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFronteggWebhooks() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists Frontegg webhooks, optionally filtered by event, enabled state, or name.",
+
+		ReadContext: dataSourceFronteggWebhooksRead,
+
+		Schema: map[string]*schema.Schema{
+			"event_key": {
+				Description: "Only return webhooks subscribed to this event key.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"enabled": {
+				Description: "Only return webhooks with this enabled state.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"name_regex": {
+				Description: "Only return webhooks whose name matches this regular expression.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"tenant_id": {
+				Description: "Only return webhooks scoped to this tenant.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"webhooks": {
+				Description: "The list of webhooks matching the given filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"events": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vendor_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFronteggWebhooksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*restclient.Client)
+
+	eventKey := d.Get("event_key").(string)
+	tenantID := d.Get("tenant_id").(string)
+	enabled, enabledSet := d.GetOkExists("enabled")
+	var nameRegex *regexp.Regexp
+	if raw, ok := d.GetOk("name_regex"); ok {
+		re, err := regexp.Compile(raw.(string))
+		if err != nil {
+			return diag.Errorf("name_regex is not a valid regular expression: %s", err)
+		}
+		nameRegex = re
+	}
+
+	// event_key, enabled, and tenant_id are pushed down as query parameters
+	// so the server filters the list instead of the provider fetching every
+	// webhook and filtering in Go. name_regex has no server-side equivalent
+	// and is always applied client-side below.
+	query := url.Values{}
+	if eventKey != "" {
+		query.Set("eventKey", eventKey)
+	}
+	if tenantID != "" {
+		query.Set("tenantId", tenantID)
+	}
+	if enabledSet {
+		query.Set("enabled", strconv.FormatBool(enabled.(bool)))
+	}
+
+	var out []fronteggWebhook
+	if err := client.Get(ctx, fronteggWebhookPath, query, &out); err != nil {
+		return diag.FromErr(err)
+	}
+
+	webhooks := make([]interface{}, 0, len(out))
+	for _, w := range out {
+		if nameRegex != nil && !nameRegex.MatchString(w.DisplayName) {
+			continue
+		}
+		webhooks = append(webhooks, map[string]interface{}{
+			"id":          w.ID,
+			"name":        w.DisplayName,
+			"enabled":     w.IsActive,
+			"description": w.Description,
+			"url":         w.URL,
+			"events":      w.EventKeys,
+			"type":        w.Type,
+			"tenant_id":   w.TenantID,
+			"vendor_id":   w.VendorID,
+			"created_at":  w.CreatedAt,
+		})
+	}
+
+	d.SetId(fronteggWebhookPath)
+	if err := d.Set("webhooks", webhooks); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
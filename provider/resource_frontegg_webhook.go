@@ -1,8 +1,12 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"text/template"
 
 	"github.com/benesch/terraform-provider-frontegg/internal/restclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,18 +16,77 @@ import (
 
 const fronteggWebhookPath = "https://portal.frontegg.com/frontegg/webhook"
 
+// fronteggWebhookTypes are the webhook flavors Frontegg knows how to deliver
+// to. "custom" posts the raw event body to an arbitrary URL; the rest are
+// delivered through a payload_template tailored to the target
+// chat/ticketing system, which the provider sends to Frontegg and only
+// renders itself at plan time, against sample payloads, to validate it.
+var fronteggWebhookTypes = []string{
+	"custom",
+	"slack",
+	"msteams",
+	"discord",
+	"dingtalk",
+	"feishu",
+	"matrix",
+	"packagist",
+}
+
 type fronteggWebhook struct {
-	ID          string   `json:"_id,omitempty"`
-	DisplayName string   `json:"displayName,omitempty"`
-	Description string   `json:"description,omitempty"`
-	URL         string   `json:"url,omitempty"`
-	Secret      string   `json:"secret,omitempty"`
-	EventKeys   []string `json:"eventKeys,omitempty"`
-	IsActive    bool     `json:"isActive"`
-	Type        string   `json:"type,omitempty"`
-	TenantID    string   `json:"tenantId,omitempty"`
-	VendorID    string   `json:"vendorId,omitempty"`
-	CreatedAt   string   `json:"createdAt,omitempty"`
+	ID                  string                `json:"_id,omitempty"`
+	DisplayName         string                `json:"displayName,omitempty"`
+	Description         string                `json:"description,omitempty"`
+	URL                 string                `json:"url,omitempty"`
+	Secret              string                `json:"secret,omitempty"`
+	EventKeys           []string              `json:"eventKeys,omitempty"`
+	IsActive            bool                  `json:"isActive"`
+	Type                string                `json:"type,omitempty"`
+	PayloadTemplate     string                `json:"payloadTemplate,omitempty"`
+	SlackChannel        string                `json:"slackChannel,omitempty"`
+	MatrixRoomID        string                `json:"matrixRoomId,omitempty"`
+	MatrixAccessToken   string                `json:"matrixAccessToken,omitempty"`
+	TeamsThemeColor     string                `json:"teamsThemeColor,omitempty"`
+	SignatureAlgorithm  string                `json:"signatureAlgorithm,omitempty"`
+	Retry               *fronteggWebhookRetry `json:"retry,omitempty"`
+	TimeoutSeconds      int                   `json:"timeoutSeconds,omitempty"`
+	RateLimitPerMinute  int                   `json:"rateLimitPerMinute,omitempty"`
+	LastDeliveryStatus  string                `json:"lastDeliveryStatus,omitempty"`
+	LastDeliveryAt      string                `json:"lastDeliveryAt,omitempty"`
+	ConsecutiveFailures int                   `json:"consecutiveFailures"`
+	TenantID            string                `json:"tenantId,omitempty"`
+	EnvironmentID       string                `json:"environmentId,omitempty"`
+	VendorID            string                `json:"vendorId,omitempty"`
+	CreatedAt           string                `json:"createdAt,omitempty"`
+}
+
+// fronteggWebhookRetry models the delivery retry policy applied when the
+// endpoint responds with a non-2xx status: up to MaxAttempts retries, spaced
+// out per BackoffStrategy ("linear" or "exponential") starting at
+// BackoffSeconds.
+type fronteggWebhookRetry struct {
+	MaxAttempts     int    `json:"maxAttempts"`
+	BackoffSeconds  int    `json:"backoffSeconds"`
+	BackoffStrategy string `json:"backoffStrategy,omitempty"`
+}
+
+// fronteggWebhookSamplePayloads gives, for each event key the resource knows
+// about, a representative payload to render payload_template against at plan
+// time. This lets us catch templates that reference fields the selected
+// events never produce before they reach apply.
+var fronteggWebhookSamplePayloads = map[string]map[string]interface{}{
+	"frontegg.user.authenticated":         {"userId": "sample-user-id", "email": "user@example.com", "tenantId": "sample-tenant-id"},
+	"frontegg.user.authenticatedWithSAML": {"userId": "sample-user-id", "email": "user@example.com", "tenantId": "sample-tenant-id"},
+	"frontegg.user.authenticatedWithSSO":  {"userId": "sample-user-id", "email": "user@example.com", "tenantId": "sample-tenant-id"},
+	"frontegg.user.failedAuthentication":  {"userId": "sample-user-id", "email": "user@example.com", "reason": "invalid_credentials"},
+	"frontegg.user.enrolledMFA":           {"userId": "sample-user-id", "email": "user@example.com"},
+	"frontegg.user.disabledMFA":           {"userId": "sample-user-id", "email": "user@example.com"},
+	"frontegg.user.created":               {"userId": "sample-user-id", "email": "user@example.com"},
+	"frontegg.user.signedUp":              {"userId": "sample-user-id", "email": "user@example.com"},
+	"frontegg.user.deleted":               {"userId": "sample-user-id", "email": "user@example.com"},
+	"frontegg.user.invitedToTenant":       {"userId": "sample-user-id", "email": "user@example.com", "tenantId": "sample-tenant-id"},
+	"frontegg.user.changedPassword":       {"userId": "sample-user-id", "email": "user@example.com"},
+	"frontegg.user.forgotPassword":        {"userId": "sample-user-id", "email": "user@example.com"},
+	"frontegg.user.removedFromTenant":     {"userId": "sample-user-id", "email": "user@example.com", "tenantId": "sample-tenant-id"},
 }
 
 func resourceFronteggWebhook() *schema.Resource {
@@ -37,6 +100,7 @@ func resourceFronteggWebhook() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceFronteggWebhookCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"enabled": {
@@ -88,15 +152,108 @@ func resourceFronteggWebhook() *schema.Resource {
 				Required: true,
 			},
 			"type": {
-				Description: "The type of the webhook.",
+				Description:  "The type of the webhook. One of `custom`, `slack`, `msteams`, `discord`, `dingtalk`, `feishu`, `matrix`, or `packagist`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "custom",
+				ValidateFunc: validation.StringInSlice(fronteggWebhookTypes, false),
+			},
+			"payload_template": {
+				Description: "A Go `text/template` used to render the outgoing payload for non-`custom` webhook types. The template is validated at plan time against a sample payload for each subscribed event.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"slack_channel": {
+				Description: "Overrides the Slack channel to post to. Only applies when `type` is `slack`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"matrix_room_id": {
+				Description: "The Matrix room to post to. Only applies when `type` is `matrix`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"matrix_access_token": {
+				Description: "The access token used to authenticate to the Matrix homeserver. Only applies when `type` is `matrix`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"teams_theme_color": {
+				Description: "The theme color (hex, without `#`) applied to the Microsoft Teams message card. Only applies when `type` is `msteams`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"retry": {
+				Description: "The delivery retry policy applied when the endpoint responds with a non-2xx status.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Description: "The maximum number of retry attempts after a failed delivery.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"backoff_seconds": {
+							Description: "The base delay, in seconds, before the first retry.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"backoff_strategy": {
+							Description:  "How the delay grows between retries. One of `linear` or `exponential`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "linear",
+							ValidateFunc: validation.StringInSlice([]string{"linear", "exponential"}, false),
+						},
+					},
+				},
+			},
+			"signature_algorithm": {
+				Description:  "The algorithm used to sign outgoing payloads. One of `hmac-sha256` or `hmac-sha1`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "hmac-sha256",
+				ValidateFunc: validation.StringInSlice([]string{"hmac-sha256", "hmac-sha1"}, false),
+			},
+			"timeout_seconds": {
+				Description: "The number of seconds to wait for the endpoint to respond before considering the delivery failed.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"rate_limit_per_minute": {
+				Description: "The maximum number of deliveries to send to this webhook per minute.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"last_delivery_status": {
+				Description: "The HTTP status code of the most recent delivery attempt.",
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"last_delivery_at": {
+				Description: "The timestamp of the most recent delivery attempt.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"consecutive_failures": {
+				Description: "The number of consecutive failed delivery attempts.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
 			"tenant_id": {
-				Description: "The ID of the tenant that owns the webhook.",
+				Description: "The ID of the tenant that owns the webhook. Leave unset to manage a vendor-level webhook.",
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 			},
+			"environment_id": {
+				Description: "The ID of the environment to scope the webhook to.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"vendor_id": {
 				Description: "The ID of the vendor that owns the webhook.",
 				Type:        schema.TypeString,
@@ -113,12 +270,37 @@ func resourceFronteggWebhook() *schema.Resource {
 
 func resourceFronteggWebhookSerialize(d *schema.ResourceData) fronteggWebhook {
 	return fronteggWebhook{
-		IsActive:    d.Get("enabled").(bool),
-		DisplayName: d.Get("name").(string),
-		Description: d.Get("description").(string),
-		URL:         d.Get("url").(string),
-		Secret:      d.Get("secret").(string),
-		EventKeys:   stringSetToList(d.Get("events").(*schema.Set)),
+		IsActive:           d.Get("enabled").(bool),
+		DisplayName:        d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		URL:                d.Get("url").(string),
+		Secret:             d.Get("secret").(string),
+		EventKeys:          stringSetToList(d.Get("events").(*schema.Set)),
+		Type:               d.Get("type").(string),
+		PayloadTemplate:    d.Get("payload_template").(string),
+		SlackChannel:       d.Get("slack_channel").(string),
+		MatrixRoomID:       d.Get("matrix_room_id").(string),
+		MatrixAccessToken:  d.Get("matrix_access_token").(string),
+		TeamsThemeColor:    d.Get("teams_theme_color").(string),
+		SignatureAlgorithm: d.Get("signature_algorithm").(string),
+		Retry:              resourceFronteggWebhookRetrySerialize(d),
+		TimeoutSeconds:     d.Get("timeout_seconds").(int),
+		RateLimitPerMinute: d.Get("rate_limit_per_minute").(int),
+		TenantID:           d.Get("tenant_id").(string),
+		EnvironmentID:      d.Get("environment_id").(string),
+	}
+}
+
+func resourceFronteggWebhookRetrySerialize(d *schema.ResourceData) *fronteggWebhookRetry {
+	raw := d.Get("retry").([]interface{})
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	r := raw[0].(map[string]interface{})
+	return &fronteggWebhookRetry{
+		MaxAttempts:     r["max_attempts"].(int),
+		BackoffSeconds:  r["backoff_seconds"].(int),
+		BackoffStrategy: r["backoff_strategy"].(string),
 	}
 }
 
@@ -139,18 +321,56 @@ func resourceFronteggWebhookDeserialize(d *schema.ResourceData, f fronteggWebhoo
 	if err := d.Set("secret", f.Secret); err != nil {
 		return err
 	}
-	if err := d.Set("events", f.EventKeys); err != nil {
+	sortedEvents := append([]string(nil), f.EventKeys...)
+	sort.Strings(sortedEvents)
+	if err := d.Set("events", sortedEvents); err != nil {
 		return err
 	}
-	if err := d.Set("events", f.EventKeys); err != nil {
+	if err := d.Set("type", f.Type); err != nil {
 		return err
 	}
-	if err := d.Set("type", f.Type); err != nil {
+	if err := d.Set("payload_template", f.PayloadTemplate); err != nil {
+		return err
+	}
+	if err := d.Set("slack_channel", f.SlackChannel); err != nil {
+		return err
+	}
+	if err := d.Set("matrix_room_id", f.MatrixRoomID); err != nil {
+		return err
+	}
+	if err := d.Set("matrix_access_token", f.MatrixAccessToken); err != nil {
+		return err
+	}
+	if err := d.Set("teams_theme_color", f.TeamsThemeColor); err != nil {
+		return err
+	}
+	if err := d.Set("signature_algorithm", f.SignatureAlgorithm); err != nil {
+		return err
+	}
+	if err := d.Set("retry", resourceFronteggWebhookRetryDeserialize(f.Retry)); err != nil {
+		return err
+	}
+	if err := d.Set("timeout_seconds", f.TimeoutSeconds); err != nil {
+		return err
+	}
+	if err := d.Set("rate_limit_per_minute", f.RateLimitPerMinute); err != nil {
+		return err
+	}
+	if err := d.Set("last_delivery_status", f.LastDeliveryStatus); err != nil {
+		return err
+	}
+	if err := d.Set("last_delivery_at", f.LastDeliveryAt); err != nil {
+		return err
+	}
+	if err := d.Set("consecutive_failures", f.ConsecutiveFailures); err != nil {
 		return err
 	}
 	if err := d.Set("tenant_id", f.TenantID); err != nil {
 		return err
 	}
+	if err := d.Set("environment_id", f.EnvironmentID); err != nil {
+		return err
+	}
 	if err := d.Set("vendor_id", f.VendorID); err != nil {
 		return err
 	}
@@ -160,11 +380,22 @@ func resourceFronteggWebhookDeserialize(d *schema.ResourceData, f fronteggWebhoo
 	return nil
 }
 
+func resourceFronteggWebhookRetryDeserialize(r *fronteggWebhookRetry) []interface{} {
+	if r == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"max_attempts":     r.MaxAttempts,
+		"backoff_seconds":  r.BackoffSeconds,
+		"backoff_strategy": r.BackoffStrategy,
+	}}
+}
+
 func resourceFronteggWebhookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*restclient.Client)
 	in := resourceFronteggWebhookSerialize(d)
 	var out fronteggWebhook
-	if err := client.Post(ctx, fronteggWebhookPath+"/custom", in, &out); err != nil {
+	if err := client.Post(ctx, fmt.Sprintf("%s/%s", fronteggWebhookPath, in.Type), in, &out); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := resourceFronteggWebhookDeserialize(d, out); err != nil {
@@ -205,3 +436,104 @@ func resourceFronteggWebhookDelete(ctx context.Context, d *schema.ResourceData,
 	}
 	return nil
 }
+
+// resourceFronteggWebhookCustomizeDiff renders payload_template, when set,
+// against a sample payload built from the webhook's subscribed events. This
+// catches templates that reference fields the selected events never produce
+// at plan time instead of failing silently on delivery.
+func resourceFronteggWebhookCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if raw, ok := d.GetOk("payload_template"); ok {
+		tmplSource := raw.(string)
+
+		tmpl, err := template.New("payload_template").Option("missingkey=error").Parse(tmplSource)
+		if err != nil {
+			return fmt.Errorf("payload_template is not a valid Go template: %w", err)
+		}
+
+		events := stringSetToList(d.Get("events").(*schema.Set))
+		for _, event := range events {
+			// Every event accepted by the "events" schema's ValidateFunc
+			// must have a matching sample payload here, or drift-catching
+			// silently stops working for it. Fail closed rather than
+			// skipping validation for an event we don't recognize.
+			sample, ok := fronteggWebhookSamplePayloads[event]
+			if !ok {
+				return fmt.Errorf("payload_template cannot be validated: no sample payload is registered for event %q", event)
+			}
+			if err := tmpl.Execute(&bytes.Buffer{}, sample); err != nil {
+				return fmt.Errorf("payload_template references a field that event %q does not provide: %w", event, err)
+			}
+		}
+	}
+
+	return suppressFronteggWebhookEventsReorderDiff(d)
+}
+
+// fronteggWebhookEventAliases maps event keys the server may substitute for
+// one another (e.g. auto-expanding a more specific alias) to a canonical
+// key, so that such substitutions are not reported as drift.
+var fronteggWebhookEventAliases = map[string]string{
+	"frontegg.user.authenticatedwithsso": "frontegg.user.authenticated",
+}
+
+func normalizeEventKey(s string) string {
+	key := strings.ToLower(strings.TrimSpace(s))
+	if canonical, ok := fronteggWebhookEventAliases[key]; ok {
+		return canonical
+	}
+	return key
+}
+
+// suppressFronteggWebhookEventsReorderDiff clears the diff on "events" when
+// the old and new sets are equal once case and known server-side alias
+// substitutions are normalized away. (events is a schema.TypeSet, so a plain
+// reordering never produces a diff in the first place; what this actually
+// guards against is the server returning a case variant or an
+// auto-expanded alias for an event the user configured.)
+func suppressFronteggWebhookEventsReorderDiff(d *schema.ResourceDiff) error {
+	if !d.HasChange("events") {
+		return nil
+	}
+	oldRaw, newRaw := d.GetChange("events")
+	oldSet, ok := oldRaw.(*schema.Set)
+	if !ok {
+		return nil
+	}
+	newSet, ok := newRaw.(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	if normalizedEventKeysEqual(stringSetToList(oldSet), stringSetToList(newSet)) {
+		return d.Clear("events")
+	}
+	return nil
+}
+
+// normalizedEventKeysEqual reports whether old and new contain the same
+// event keys once each is lowercased, trimmed, and resolved through
+// fronteggWebhookEventAliases. Set membership is independent of order, so
+// this is unaffected by the order either slice is in.
+func normalizedEventKeysEqual(oldKeys, newKeys []string) bool {
+	oldNormalized := normalizeEventKeys(oldKeys)
+	newNormalized := normalizeEventKeys(newKeys)
+	if len(oldNormalized) != len(newNormalized) {
+		return false
+	}
+	sort.Strings(oldNormalized)
+	sort.Strings(newNormalized)
+	for i := range oldNormalized {
+		if oldNormalized[i] != newNormalized[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeEventKeys(events []string) []string {
+	normalized := make([]string, len(events))
+	for i, e := range events {
+		normalized[i] = normalizeEventKey(e)
+	}
+	return normalized
+}
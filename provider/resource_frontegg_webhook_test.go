@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceFronteggWebhookDeserializeEvents(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceFronteggWebhook().Schema, map[string]interface{}{})
+
+	in := fronteggWebhook{
+		ID:        "test-id",
+		EventKeys: []string{"frontegg.user.created", "frontegg.user.authenticated"},
+	}
+	if err := resourceFronteggWebhookDeserialize(d, in); err != nil {
+		t.Fatalf("resourceFronteggWebhookDeserialize returned an error: %v", err)
+	}
+
+	got := stringSetToList(d.Get("events").(*schema.Set))
+	sort.Strings(got)
+	want := []string{"frontegg.user.authenticated", "frontegg.user.created"}
+	if len(got) != len(want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("events = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestNormalizedEventKeysEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		old  []string
+		new  []string
+		want bool
+	}{
+		{
+			name: "identical",
+			old:  []string{"frontegg.user.created", "frontegg.user.deleted"},
+			new:  []string{"frontegg.user.created", "frontegg.user.deleted"},
+			want: true,
+		},
+		{
+			name: "different order is still equal",
+			old:  []string{"frontegg.user.created", "frontegg.user.deleted"},
+			new:  []string{"frontegg.user.deleted", "frontegg.user.created"},
+			want: true,
+		},
+		{
+			name: "case and whitespace differences are ignored",
+			old:  []string{"frontegg.user.created"},
+			new:  []string{" Frontegg.User.Created "},
+			want: true,
+		},
+		{
+			name: "known alias is treated as equal",
+			old:  []string{"frontegg.user.authenticated"},
+			new:  []string{"frontegg.user.authenticatedWithSSO"},
+			want: true,
+		},
+		{
+			name: "genuinely different events are not equal",
+			old:  []string{"frontegg.user.created"},
+			new:  []string{"frontegg.user.deleted"},
+			want: false,
+		},
+		{
+			name: "different lengths are not equal",
+			old:  []string{"frontegg.user.created"},
+			new:  []string{"frontegg.user.created", "frontegg.user.deleted"},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizedEventKeysEqual(tt.old, tt.new); got != tt.want {
+				t.Errorf("normalizedEventKeysEqual(%v, %v) = %v, want %v", tt.old, tt.new, got, tt.want)
+			}
+		})
+	}
+}
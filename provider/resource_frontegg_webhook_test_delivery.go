@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/benesch/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fronteggWebhookTestDelivery is the response from Frontegg's test-webhook
+// endpoint: the outcome of a single synthetic delivery.
+type fronteggWebhookTestDelivery struct {
+	StatusCode int    `json:"statusCode"`
+	Body       string `json:"body,omitempty"`
+	Signature  string `json:"signature,omitempty"`
+}
+
+func resourceFronteggWebhookTest() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sends a synthetic event through a `frontegg_webhook` and records the delivery outcome. Destroying this resource has no effect; it exists only to trigger and capture a test delivery.",
+
+		CreateContext: resourceFronteggWebhookTestCreate,
+		ReadContext:   resourceFronteggWebhookTestRead,
+		DeleteContext: resourceFronteggWebhookTestDelete,
+
+		Schema: map[string]*schema.Schema{
+			"webhook_id": {
+				Description: "The ID of the `frontegg_webhook` to test.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"event_key": {
+				Description: "The event key to send a synthetic payload for.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"status_code": {
+				Description: "The HTTP status code returned by the webhook endpoint.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"response_body": {
+				Description: "The response body returned by the webhook endpoint.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"signature": {
+				Description: "The signature header Frontegg attached to the test delivery.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceFronteggWebhookTestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*restclient.Client)
+
+	webhookID := d.Get("webhook_id").(string)
+	in := map[string]interface{}{
+		"eventKey": d.Get("event_key").(string),
+	}
+	var out fronteggWebhookTestDelivery
+	if err := client.Post(ctx, fmt.Sprintf("%s/%s/test", fronteggWebhookPath, webhookID), in, &out); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", webhookID, d.Get("event_key").(string)))
+	if err := d.Set("status_code", out.StatusCode); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("response_body", out.Body); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("signature", out.Signature); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceFronteggWebhookTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The recorded delivery outcome is a point-in-time result; there is
+	// nothing to refresh from the server.
+	return nil
+}
+
+func resourceFronteggWebhookTestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Test deliveries aren't persisted server-side beyond the initial
+	// response, so destroying this resource is a no-op.
+	return nil
+}
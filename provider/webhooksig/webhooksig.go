@@ -0,0 +1,62 @@
+// Package webhooksig verifies the HMAC signatures Frontegg attaches to
+// outgoing webhook deliveries, so that downstream consumers of deliveries
+// produced by frontegg_webhook resources can authenticate them without
+// depending on the Frontegg SDK.
+package webhooksig
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+)
+
+// ErrInvalidSignature is returned by Verify when the signature header does
+// not match the computed signature for the given secret and body.
+var ErrInvalidSignature = errors.New("webhooksig: signature does not match")
+
+// Verify checks that header is a valid HMAC-SHA256 signature of body using
+// secret, as Frontegg computes it for the `X-Frontegg-Signature` header on
+// outgoing webhook deliveries. It returns ErrInvalidSignature if the
+// signature does not match, or a wrapping error if header is malformed.
+func Verify(secret string, body []byte, header string) error {
+	return VerifyWithAlgorithm("hmac-sha256", secret, body, header)
+}
+
+// VerifyWithAlgorithm is like Verify but accepts the signature_algorithm
+// configured on the frontegg_webhook resource ("hmac-sha256" or
+// "hmac-sha1").
+func VerifyWithAlgorithm(algorithm, secret string, body []byte, header string) error {
+	newHash, err := hasherFor(algorithm)
+	if err != nil {
+		return err
+	}
+
+	want, err := hex.DecodeString(header)
+	if err != nil {
+		return fmt.Errorf("webhooksig: malformed signature header: %w", err)
+	}
+
+	mac := hmac.New(newHash, []byte(secret))
+	mac.Write(body)
+	got := mac.Sum(nil)
+
+	if !hmac.Equal(want, got) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func hasherFor(algorithm string) (func() hash.Hash, error) {
+	switch algorithm {
+	case "hmac-sha256":
+		return sha256.New, nil
+	case "hmac-sha1":
+		return sha1.New, nil
+	default:
+		return nil, fmt.Errorf("webhooksig: unsupported signature algorithm %q", algorithm)
+	}
+}
@@ -0,0 +1,82 @@
+package webhooksig
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	secret := "shh-its-a-secret"
+	body := []byte(`{"event":"frontegg.user.created"}`)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	header := hex.EncodeToString(mac.Sum(nil))
+
+	if err := Verify(secret, body, header); err != nil {
+		t.Fatalf("Verify returned an error for a valid signature: %v", err)
+	}
+}
+
+func TestVerifyWithAlgorithm(t *testing.T) {
+	secret := "shh-its-a-secret"
+	body := []byte(`{"event":"frontegg.user.created"}`)
+
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(body)
+	header := hex.EncodeToString(mac.Sum(nil))
+
+	if err := VerifyWithAlgorithm("hmac-sha1", secret, body, header); err != nil {
+		t.Fatalf("VerifyWithAlgorithm returned an error for a valid hmac-sha1 signature: %v", err)
+	}
+}
+
+func TestVerifyWrongSecret(t *testing.T) {
+	body := []byte(`{"event":"frontegg.user.created"}`)
+
+	mac := hmac.New(sha256.New, []byte("correct-secret"))
+	mac.Write(body)
+	header := hex.EncodeToString(mac.Sum(nil))
+
+	err := Verify("wrong-secret", body, header)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Verify error = %v, want ErrInvalidSignature", err)
+	}
+}
+
+func TestVerifyTamperedBody(t *testing.T) {
+	secret := "shh-its-a-secret"
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(`{"event":"frontegg.user.created"}`))
+	header := hex.EncodeToString(mac.Sum(nil))
+
+	err := Verify(secret, []byte(`{"event":"frontegg.user.deleted"}`), header)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Verify error = %v, want ErrInvalidSignature", err)
+	}
+}
+
+func TestVerifyWithAlgorithmUnsupported(t *testing.T) {
+	err := VerifyWithAlgorithm("hmac-md5", "secret", []byte("body"), "deadbeef")
+	if err == nil {
+		t.Fatal("VerifyWithAlgorithm returned no error for an unsupported algorithm")
+	}
+	if errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("VerifyWithAlgorithm error = %v, want an unsupported-algorithm error, not ErrInvalidSignature", err)
+	}
+}
+
+func TestVerifyMalformedHeader(t *testing.T) {
+	err := Verify("secret", []byte("body"), "not-valid-hex")
+	if err == nil {
+		t.Fatal("Verify returned no error for a malformed signature header")
+	}
+	if errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Verify error = %v, want a malformed-header error, not ErrInvalidSignature", err)
+	}
+}